@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	"github.com/cosmos/cosmos-sdk/version"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Flag names and values
+const (
+	FlagSpendLimit       = "spend-limit"
+	FlagAllowList        = "allow-list"
+	FlagExpiration       = "expiration"
+	FlagPeriod           = "period"
+	FlagPeriodSpendLimit = "periodic-send-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(ac address.Codec) *cobra.Command {
+	AuthorizationTxCmd := &cobra.Command{
+		Use:                        authz.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		Long:                       "Authorize and revoke access to execute transactions on behalf of your address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	AuthorizationTxCmd.AddCommand(
+		NewCmdGrantAuthorization(ac),
+		NewCmdRevokeAuthorization(ac),
+	)
+
+	return AuthorizationTxCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction
+// granting a bank send authorization, optionally bounded to a recurring period via
+// --period and --periodic-send-limit.
+func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant <grantee> send --spend-limit=<coins> --from <granter>",
+		Short: "Grant a bank send authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create a new grant authorization to an address to send coins on your behalf:
+
+Examples:
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --period=3600 --periodic-send-limit=100stake --from=cosmos1skl..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[1] != "send" {
+				return fmt.Errorf("invalid authorization type, %s", args[1])
+			}
+
+			grantee, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(limit)
+			if err != nil {
+				return err
+			}
+
+			if !spendLimit.IsAllPositive() {
+				return fmt.Errorf("spend-limit should be greater than zero")
+			}
+
+			allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+			if err != nil {
+				return err
+			}
+
+			allowed, err := bech32toAccAddresses(allowList, ac)
+			if err != nil {
+				return err
+			}
+
+			period, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			periodLimit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			switch {
+			case period > 0 || periodLimit != "":
+				if period <= 0 {
+					return fmt.Errorf("%s is required when %s is set", FlagPeriod, FlagPeriodSpendLimit)
+				}
+				if periodLimit == "" {
+					return fmt.Errorf("%s is required when %s is set", FlagPeriodSpendLimit, FlagPeriod)
+				}
+
+				periodSpendLimit, err := sdk.ParseCoinsNormalized(periodLimit)
+				if err != nil {
+					return err
+				}
+
+				authorization = bank.NewPeriodicSendAuthorization(spendLimit, time.Duration(period)*time.Second, periodSpendLimit, time.Now(), allowed, ac)
+			default:
+				authorization = bank.NewSendAuthorization(spendLimit, allowed, ac)
+			}
+
+			expire, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for a bank send authorization, an array of Coins allowed to be spent")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses the grantee is allowed to send funds to, separated by ,")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period, in seconds, after which period-can-spend resets to periodic-send-limit. Set together with periodic-send-limit to grant a PeriodicSendAuthorization instead of a SendAuthorization.")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "PeriodSpendLimit for a periodic bank send authorization, an array of Coins spendable per period. Set together with period.")
+	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	return cmd
+}
+
+func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
+	exp, err := cmd.Flags().GetInt64(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if exp == 0 {
+		return nil, nil
+	}
+	e := time.Unix(exp, 0)
+	return &e, nil
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a MsgRevoke transaction.
+func NewCmdRevokeAuthorization(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type-url] --from=[granter]",
+		Short: "revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke authorization from a granter to a grantee:
+Example:
+ $ %s tx %s revoke cosmos1skj.. %s --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			msgAuthorized := args[1]
+			msg := authz.NewMsgRevoke(granter, grantee, msgAuthorized)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func bech32toAccAddresses(accAddrs []string, ac address.Codec) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(accAddrs))
+	for i, addr := range accAddrs {
+		acc, err := ac.StringToBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = acc
+	}
+	return addrs, nil
+}
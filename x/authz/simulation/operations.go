@@ -0,0 +1,148 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktype "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// authz message types
+var TypeMsgGrant = sdk.MsgTypeURL(&authz.MsgGrant{})
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrant = "op_weight_msg_grant"
+
+	// weights used to pick which Authorization a grant operation emits.
+	OpWeightGrantSend     = "op_weight_grant_send"
+	OpWeightGrantPeriodic = "op_weight_grant_periodic_send"
+
+	DefaultWeightMsgGrant int = 100
+
+	DefaultWeightGrantSend     int = 60
+	DefaultWeightGrantPeriodic int = 40
+
+	// minPeriod and maxPeriod bound the randomized PeriodicSendAuthorization.Period.
+	minPeriod = time.Hour
+	maxPeriod = 30 * 24 * time.Hour
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak authz.AccountKeeper,
+	bk authz.BankKeeper,
+	ac address.Codec,
+) simulation.WeightedOperations {
+	var weightMsgGrant int
+	appParams.GetOrGenerate(OpWeightMsgGrant, &weightMsgGrant, nil, func(_ *rand.Rand) {
+		weightMsgGrant = DefaultWeightMsgGrant
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrant,
+			SimulateMsgGrant(codec.NewProtoCodec(registry), txGen, ak, bk, ac),
+		),
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant with random values, emitting either a
+// SendAuthorization or a PeriodicSendAuthorization so both grant shapes are
+// exercised in full-app simulations.
+func SimulateMsgGrant(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak authz.AccountKeeper,
+	bk authz.BankKeeper,
+	ac address.Codec,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, "granter and grantee are same"), nil, nil
+		}
+
+		granterAcc := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, granter.Address)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, err.Error()), nil, err
+		}
+
+		spendLimit := spendableCoins.Sub(fees...)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, "spend limit is nil"), nil, nil
+		}
+
+		var expiration *time.Time
+		t1 := simtypes.RandTimestamp(r)
+		if !t1.Before(ctx.BlockTime()) {
+			expiration = &t1
+		}
+
+		randomAuthz := generateRandomAuthorization(r, ctx.BlockTime(), spendLimit, ac)
+
+		msg, err := authz.NewMsgGrant(granter.Address, grantee.Address, randomAuthz, expiration)
+		if err != nil {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, err.Error()), nil, err
+		}
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txCfg,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{granterAcc.GetAccountNumber()},
+			[]uint64{granterAcc.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimTxFinalizeBlock(txCfg.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(authz.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// generateRandomAuthorization picks, weighted by DefaultWeightGrantSend and
+// DefaultWeightGrantPeriodic, either a plain SendAuthorization or a
+// PeriodicSendAuthorization bounded by spendLimit. The OpWeightGrantSend and
+// OpWeightGrantPeriodic app params are not read.
+func generateRandomAuthorization(r *rand.Rand, blockTime time.Time, spendLimit sdk.Coins, ac address.Codec) authz.Authorization {
+	if r.Intn(DefaultWeightGrantSend+DefaultWeightGrantPeriodic) >= DefaultWeightGrantSend {
+		period := minPeriod + time.Duration(r.Int63n(int64(maxPeriod-minPeriod)))
+		periodSpendLimit := simtypes.RandSubsetCoins(r, spendLimit)
+		if periodSpendLimit.Empty() {
+			periodSpendLimit = spendLimit
+		}
+
+		return banktype.NewPeriodicSendAuthorization(spendLimit, period, periodSpendLimit, blockTime, nil, ac)
+	}
+
+	return banktype.NewSendAuthorization(spendLimit, nil, ac)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+)
+
+// RegisterInterfaces registers this package's authz.Authorization
+// implementations with the interface registry. This is the sole
+// registration point for the package's Authorization implementations;
+// do not register SendAuthorization or PeriodicSendAuthorization anywhere
+// else.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&SendAuthorization{},
+		&PeriodicSendAuthorization{},
+	)
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object. The
+// periodReset, the first time the period spend limit resets, is set to
+// periodStart+period.
+func NewPeriodicSendAuthorization(spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins, periodStart time.Time, allowed []sdk.AccAddress, addressCodec address.Codec) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      periodStart.Add(period),
+		AllowList:        toBech32Addresses(allowed, addressCodec),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. Each call advances the period
+// window if blockTime has reached PeriodReset, then debits msg.Amount from
+// both the period allowance and the overall SpendLimit.
+func (a PeriodicSendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	isAddrExists := false
+	toAddr := mSend.ToAddress
+	allowedList := a.GetAllowList()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, addr := range allowedList {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "periodic send authorization")
+		if addr == toAddr {
+			isAddrExists = true
+			break
+		}
+	}
+
+	if len(allowedList) > 0 && !isAddrExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
+	}
+
+	blockTime := sdkCtx.HeaderInfo().Time
+	periodCanSpend, periodReset := a.PeriodCanSpend, a.PeriodReset
+	if !blockTime.Before(periodReset) {
+		periodCanSpend, periodReset = tryResetPeriod(blockTime, a.Period, a.PeriodSpendLimit, a.SpendLimit)
+	}
+
+	spendLimitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	periodCanSpendLeft, isNegative := periodCanSpend.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+	}
+
+	if spendLimitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept: true,
+		Delete: false,
+		Updated: &PeriodicSendAuthorization{
+			SpendLimit:       spendLimitLeft,
+			Period:           a.Period,
+			PeriodSpendLimit: a.PeriodSpendLimit,
+			PeriodCanSpend:   periodCanSpendLeft,
+			PeriodReset:      periodReset,
+			AllowList:        allowedList,
+		},
+	}, nil
+}
+
+// tryResetPeriod returns the period-can-spend and period-reset that result
+// from rolling the period over at blockTime: periodCanSpend resets to
+// periodSpendLimit, capped by the remaining overall spendLimit, and
+// periodReset is anchored to blockTime so it catches up even if several
+// periods elapsed with no Accept call in between. Unlike
+// feegrant.PeriodicAllowance.tryResetPeriod, which steps from the previous
+// reset while blockTime is within one period of it, the new reset is always
+// blockTime+period.
+func tryResetPeriod(blockTime time.Time, period time.Duration, periodSpendLimit, spendLimit sdk.Coins) (sdk.Coins, time.Time) {
+	periodCanSpend := periodSpendLimit
+	if periodCanSpend.IsAnyGT(spendLimit) {
+		periodCanSpend = spendLimit
+	}
+
+	return periodCanSpend, blockTime.Add(period)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if len(a.SpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	if len(a.PeriodSpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit cannot be nil")
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit must be positive")
+	}
+	if a.PeriodSpendLimit.IsAnyGT(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit cannot exceed the spend limit")
+	}
+
+	found := make(map[string]bool, 0)
+	for i := 0; i < len(a.AllowList); i++ {
+		if found[a.AllowList[i]] {
+			return ErrDuplicateEntry
+		}
+
+		found[a.AllowList[i]] = true
+	}
+
+	return nil
+}
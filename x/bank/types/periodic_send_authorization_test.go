@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	periodicFromAddr    = sdk.AccAddress("_____from _____")
+	periodicToAddr      = sdk.AccAddress("_______to________")
+	periodicUnknownAddr = sdk.AccAddress("_____unknown_____")
+	periodicAC          = addresscodec.NewBech32Codec("cosmos")
+)
+
+func periodicTestCtx(blockTime time.Time) sdk.Context {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	return ctx.WithHeaderInfo(header.Info{Time: blockTime})
+}
+
+func TestPeriodicSendAuthorization_Accept(t *testing.T) {
+	now := time.Now()
+	coins1000 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000)))
+	coins500 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500)))
+	coins100 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+
+	t.Log("spend within the period limit leaves SpendLimit and PeriodCanSpend debited")
+	authorization := types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, now, nil, periodicAC)
+	require.NoError(t, authorization.ValidateBasic())
+
+	ctx := periodicTestCtx(now)
+	send := types.NewMsgSend(periodicFromAddr, periodicToAddr, coins100)
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins1000.Sub(coins100...).String(), updated.SpendLimit.String())
+	require.Equal(t, coins500.Sub(coins100...).String(), updated.PeriodCanSpend.String())
+	require.Equal(t, authorization.PeriodReset, updated.PeriodReset)
+
+	t.Log("spending more than the period limit, but within the overall limit, is rejected")
+	authorization = types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins100, now, nil, periodicAC)
+	send = types.NewMsgSend(periodicFromAddr, periodicToAddr, coins500)
+	_, err = authorization.Accept(ctx, send)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "more than period spend limit")
+
+	t.Log("period resets once blockTime reaches PeriodReset, catching up to the current period")
+	authorization = types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins100, now, nil, periodicAC)
+	laterCtx := periodicTestCtx(now.Add(3 * time.Hour))
+	send = types.NewMsgSend(periodicFromAddr, periodicToAddr, coins100)
+	resp, err = authorization.Accept(laterCtx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins100.Sub(coins100...).String(), updated.PeriodCanSpend.String())
+	require.WithinDuration(t, now.Add(3*time.Hour).Add(time.Hour), updated.PeriodReset, 0)
+
+	t.Log("after a period reset with PeriodSpendLimit above SpendLimit, spending all of SpendLimit deletes the grant")
+	authorization = types.NewPeriodicSendAuthorization(coins100, time.Hour, coins500, now, nil, periodicAC)
+	resp, err = authorization.Accept(laterCtx, types.NewMsgSend(periodicFromAddr, periodicToAddr, coins100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("exhausting the overall SpendLimit deletes the grant")
+	authorization = types.NewPeriodicSendAuthorization(coins100, time.Hour, coins100, now, nil, periodicAC)
+	resp, err = authorization.Accept(ctx, types.NewMsgSend(periodicFromAddr, periodicToAddr, coins100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("allow list rejects sends to addresses outside it")
+	allowList := []sdk.AccAddress{periodicToAddr}
+	authzWithAllowList := types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, now, allowList, periodicAC)
+	_, err = authzWithAllowList.Accept(ctx, types.NewMsgSend(periodicFromAddr, periodicUnknownAddr, coins100))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot send to")
+
+	t.Log("allow list accepts sends to addresses within it")
+	resp, err = authzWithAllowList.Accept(ctx, types.NewMsgSend(periodicFromAddr, periodicToAddr, coins100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
+
+func TestPeriodicSendAuthorization_ValidateBasic(t *testing.T) {
+	now := time.Now()
+	coins1000 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000)))
+	coins500 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500)))
+
+	testCases := []struct {
+		name   string
+		auth   *types.PeriodicSendAuthorization
+		errMsg string
+	}{
+		{
+			name: "valid",
+			auth: types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, now, nil, periodicAC),
+		},
+		{
+			name:   "empty spend limit",
+			auth:   types.NewPeriodicSendAuthorization(sdk.Coins{}, time.Hour, coins500, now, nil, periodicAC),
+			errMsg: "spend limit cannot be nil",
+		},
+		{
+			name:   "non-positive period",
+			auth:   types.NewPeriodicSendAuthorization(coins1000, 0, coins500, now, nil, periodicAC),
+			errMsg: "period must be positive",
+		},
+		{
+			name:   "empty period spend limit",
+			auth:   types.NewPeriodicSendAuthorization(coins1000, time.Hour, sdk.Coins{}, now, nil, periodicAC),
+			errMsg: "period spend limit cannot be nil",
+		},
+		{
+			name:   "period spend limit exceeds spend limit",
+			auth:   types.NewPeriodicSendAuthorization(coins500, time.Hour, coins1000, now, nil, periodicAC),
+			errMsg: "period spend limit cannot exceed the spend limit",
+		},
+		{
+			name:   "duplicate allow list entry",
+			auth:   types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, now, []sdk.AccAddress{periodicToAddr, periodicToAddr}, periodicAC),
+			errMsg: types.ErrDuplicateEntry.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package feegrant
+
+import (
+	"cosmossdk.io/x/feegrant/simulation"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// GenerateGenesisState creates a randomized GenesisState for the feegrant
+// module so full-app simulations start with a warm grant table instead of
+// an empty one.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for feegrant module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// AppModule has no ProposalMsgs hook, so full-app simulations generate no
+// governance proposal messages for feegrant.
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/types/kv"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KV
+// pairs from the feegrant store and returns a human-readable diff of the
+// two values. Prefixes unknown to feegrant cause a panic, consistent with
+// other modules' simulation decoders.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, feegrant.FeeAllowanceKeyPrefix):
+			var grantA, grantB feegrant.Grant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("A: %v\nB: %v", grantA, grantB)
+
+		case bytes.HasPrefix(kvA.Key, feegrant.FeeAllowanceQueueKeyPrefix):
+			return fmt.Sprintf("A: %X\nB: %X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid feegrant key prefix %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types/kv"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/simulation"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+)
+
+func TestDecodeStore(t *testing.T) {
+	interfaceRegistry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	dec := simulation.NewDecodeStore(cdc)
+
+	grant, err := feegrant.NewGrant("cosmos1granter", "cosmos1grantee", &feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	grantBz, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	kvPairs := []kv.Pair{
+		{Key: feegrant.FeeAllowanceKeyPrefix, Value: grantBz},
+		{Key: feegrant.FeeAllowanceQueueKeyPrefix, Value: []byte{0x01}},
+		{Key: []byte{0x99}, Value: []byte{0x00}},
+	}
+
+	tests := []struct {
+		name        string
+		expectPanic bool
+		expectedLog string
+	}{
+		{"Grant", false, fmt.Sprintf("A: %v\nB: %v", grant, grant)},
+		{"Queue entry", false, "A: 01\nB: 01"},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectPanic {
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
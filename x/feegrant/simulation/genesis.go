@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomizedGenState generates a random GenesisState for feegrant, pre-populating
+// the grant store with a handful of grants so that a full-app simulation has
+// something for the revoke and prune operations to act on from block one.
+func RandomizedGenState(simState *module.SimulationState) {
+	grants := genGrants(simState.Rand, simState.Accounts, simState.GenTimestamp)
+
+	feegrantGenesis := feegrant.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %d feegrant grants\n", len(feegrantGenesis.Allowances))
+	simState.GenState[feegrant.ModuleName] = simState.Cdc.MustMarshalJSON(feegrantGenesis)
+}
+
+// genGrants builds a random set of feegrant.Grant entries from accs, skipping
+// self-grants and mixing all three concrete allowance types using the same
+// default weights and generators as SimulateMsgGrantAllowance.
+func genGrants(r *rand.Rand, accs []simtypes.Account, blockTime time.Time) []feegrant.Grant {
+	if len(accs) < 2 {
+		return nil
+	}
+
+	weights := allowanceWeights{
+		basic:    DefaultWeightGrantAllowanceBasic,
+		periodic: DefaultWeightGrantAllowancePeriodic,
+		filtered: DefaultWeightGrantAllowanceFiltered,
+	}
+
+	numGrants := r.Intn(len(accs))
+	seenPairs := make(map[string]bool, numGrants)
+	grants := make([]feegrant.Grant, 0, numGrants)
+
+	for i := 0; i < numGrants; i++ {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			continue
+		}
+
+		granterStr, granteeStr := granter.Address.String(), grantee.Address.String()
+		pairKey := granterStr + "/" + granteeStr
+		if seenPairs[pairKey] {
+			continue
+		}
+		seenPairs[pairKey] = true
+
+		spendLimit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(simtypes.RandIntBetween(r, 100, 1000000))))
+
+		allowance, err := genRandomAllowance(r, blockTime, spendLimit, weights)
+		if err != nil {
+			continue
+		}
+
+		grant, err := feegrant.NewGrant(granterStr, granteeStr, allowance)
+		if err != nil {
+			continue
+		}
+
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
@@ -0,0 +1,96 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/simulation"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// newSimState builds a module.SimulationState seeded deterministically from
+// seed, so two calls with the same seed produce identical accounts and RNG
+// state going into RandomizedGenState.
+func newSimState(t *testing.T, seed int64) *module.SimulationState {
+	t.Helper()
+
+	interfaceRegistry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	r := rand.New(rand.NewSource(seed))
+	accounts := simtypes.RandomAccounts(r, 4)
+
+	return &module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		GenState:     make(map[string]json.RawMessage),
+		Rand:         r,
+		NumBonded:    4,
+		Accounts:     accounts,
+		InitialStake: math.NewInt(1000),
+		GenTimestamp: simtypes.RandTimestamp(rand.New(rand.NewSource(seed))),
+		BondDenom:    sdk.DefaultBondDenom,
+	}
+}
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState
+// and checks that every generated grant respects feegrant's invariants.
+func TestRandomizedGenState(t *testing.T) {
+	simState := newSimState(t, 1)
+	accounts := simState.Accounts
+
+	simulation.RandomizedGenState(simState)
+
+	var feegrantGenesis feegrant.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[feegrant.ModuleName], &feegrantGenesis)
+
+	require.LessOrEqual(t, len(feegrantGenesis.Allowances), len(accounts))
+
+	seenPairs := make(map[string]bool, len(feegrantGenesis.Allowances))
+	for _, grant := range feegrantGenesis.Allowances {
+		require.NotEqual(t, grant.Granter, grant.Grantee)
+
+		pairKey := grant.Granter + "/" + grant.Grantee
+		require.False(t, seenPairs[pairKey], "duplicate granter/grantee pair %s", pairKey)
+		seenPairs[pairKey] = true
+
+		allowance, err := grant.GetGrant()
+		require.NoError(t, err)
+
+		switch allowance := allowance.(type) {
+		case *feegrant.PeriodicAllowance:
+			require.Positive(t, allowance.Period)
+			require.False(t, allowance.PeriodSpendLimit.IsAnyGT(allowance.Basic.SpendLimit))
+		case *feegrant.AllowedMsgAllowance:
+			require.NotEmpty(t, allowance.AllowedMsgs)
+		case *feegrant.BasicAllowance:
+			require.NotNil(t, allowance.Expiration)
+		default:
+			t.Fatalf("unexpected allowance type %T", allowance)
+		}
+	}
+}
+
+// TestRandomizedGenState_DeterministicForSeed asserts that RandomizedGenState
+// is a pure function of its inputs: the same seed must always produce the
+// same genesis bytes.
+func TestRandomizedGenState_DeterministicForSeed(t *testing.T) {
+	first := newSimState(t, 42)
+	simulation.RandomizedGenState(first)
+
+	second := newSimState(t, 42)
+	simulation.RandomizedGenState(second)
+
+	require.Equal(t, first.GenState[feegrant.ModuleName], second.GenState[feegrant.ModuleName])
+}
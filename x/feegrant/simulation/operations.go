@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/keeper"
@@ -9,22 +10,67 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgGrantAllowance        = "op_weight_msg_grant_fee_allowance"
-	OpWeightMsgRevokeAllowance       = "op_weight_msg_grant_revoke_allowance"
+	OpWeightMsgGrantAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeAllowance = "op_weight_msg_grant_revoke_allowance"
+
+	// weights used to pick which concrete allowance type a grant operation emits.
+	OpWeightGrantAllowanceBasic    = "op_weight_grant_basic"
+	OpWeightGrantAllowancePeriodic = "op_weight_grant_periodic"
+	OpWeightGrantAllowanceFiltered = "op_weight_grant_filtered"
+
 	DefaultWeightGrantAllowance  int = 100
 	DefaultWeightRevokeAllowance int = 100
+
+	DefaultWeightGrantAllowanceBasic    int = 40
+	DefaultWeightGrantAllowancePeriodic int = 30
+	DefaultWeightGrantAllowanceFiltered int = 30
+
+	// minPeriod and maxPeriod bound the randomized PeriodicAllowance.Period.
+	minPeriod = time.Hour
+	maxPeriod = 30 * 24 * time.Hour
+
+	// minShortExpiration and maxShortExpiration bound the occasional
+	// short-lived expirations that let MsgPruneAllowances find work to do.
+	minShortExpiration = 5 * time.Second
+	maxShortExpiration = 10 * time.Minute
+
+	// shortExpirationChance is the percent chance that a generated allowance
+	// gets a short expiration instead of the one-year default.
+	shortExpirationChance = 30
+
+	OpWeightMsgPruneAllowances          = "op_weight_msg_prune_allowances"
+	DefaultWeightMsgPruneAllowances int = 50
 )
 
 var (
 	TypeMsgGrantAllowance  = sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{})
 	TypeMsgRevokeAllowance = sdk.MsgTypeURL(&feegrant.MsgRevokeAllowance{})
+	TypeMsgPruneAllowances = sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})
+
+	// allowedMsgTypeURLs are the message types SimulateMsgGrantAllowance draws
+	// from when it builds an AllowedMsgAllowance, so that downstream Accept
+	// paths exercise the message-filter logic against real message types.
+	allowedMsgTypeURLs = []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	}
 )
 
+// allowanceWeights holds the relative weights used to pick a concrete
+// feegrant.FeeAllowanceI implementation when simulating MsgGrantAllowance.
+type allowanceWeights struct {
+	basic    int
+	periodic int
+	filtered int
+}
+
 func WeightedOperations(
 	appParams simtypes.AppParams,
 	txConfig client.TxConfig,
@@ -35,6 +81,10 @@ func WeightedOperations(
 	var (
 		weightMsgGrantAllowance  int
 		weightMsgRevokeAllowance int
+		weightMsgPruneAllowances int
+		weightGrantBasic         int
+		weightGrantPeriodic      int
+		weightGrantFiltered      int
 	)
 
 	appParams.GetOrGenerate(OpWeightMsgGrantAllowance, &weightMsgGrantAllowance, nil,
@@ -49,24 +99,60 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightGrantAllowanceBasic, &weightGrantBasic, nil,
+		func(_ *rand.Rand) {
+			weightGrantBasic = DefaultWeightGrantAllowanceBasic
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightGrantAllowancePeriodic, &weightGrantPeriodic, nil,
+		func(_ *rand.Rand) {
+			weightGrantPeriodic = DefaultWeightGrantAllowancePeriodic
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightGrantAllowanceFiltered, &weightGrantFiltered, nil,
+		func(_ *rand.Rand) {
+			weightGrantFiltered = DefaultWeightGrantAllowanceFiltered
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgPruneAllowances, &weightMsgPruneAllowances, nil,
+		func(_ *rand.Rand) {
+			weightMsgPruneAllowances = DefaultWeightMsgPruneAllowances
+		},
+	)
+
+	weights := allowanceWeights{
+		basic:    weightGrantBasic,
+		periodic: weightGrantPeriodic,
+		filtered: weightGrantFiltered,
+	}
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgGrantAllowance,
-			SimulateMsgGrantAllowance(txConfig, ak, bk, k),
+			SimulateMsgGrantAllowance(txConfig, ak, bk, k, weights),
 		),
 		simulation.NewWeightedOperation(
 			weightMsgRevokeAllowance,
 			SimulateMsgRevokeAllowance(txConfig, ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgPruneAllowances,
+			SimulateMsgPruneAllowances(txConfig, ak, bk, k),
+		),
 	}
 }
 
-// SimulateMsgGrantAllowance generates MsgGrantAllowance with random values.
+// SimulateMsgGrantAllowance generates MsgGrantAllowance with random values,
+// picking one of feegrant's concrete allowance types according to weights.
 func SimulateMsgGrantAllowance(
 	txConfig client.TxConfig,
 	ak feegrant.AccountKeeper,
 	bk feegrant.BankKeeper,
 	k keeper.Keeper,
+	weights allowanceWeights,
 ) simtypes.Operation {
 	return func(
 		r *rand.Rand, app simtypes.AppEntrypoint, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -97,11 +183,12 @@ func SimulateMsgGrantAllowance(
 			return simtypes.NoOpMsg(feegrant.ModuleName, TypeMsgGrantAllowance, "unable to grant empty coins as SpendLimit"), nil, nil
 		}
 
-		oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
-		msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{
-			SpendLimit: spendableCoins,
-			Expiration: &oneYear,
-		}, granterStr, granteeStr)
+		allowance, err := genRandomAllowance(r, ctx.HeaderInfo().Time, spendableCoins, weights)
+		if err != nil {
+			return simtypes.NoOpMsg(feegrant.ModuleName, TypeMsgGrantAllowance, err.Error()), nil, nil
+		}
+
+		msg, err := feegrant.NewMsgGrantAllowance(allowance, granterStr, granteeStr)
 		if err != nil {
 			return simtypes.NoOpMsg(feegrant.ModuleName, TypeMsgGrantAllowance, err.Error()), nil, err
 		}
@@ -124,6 +211,134 @@ func SimulateMsgGrantAllowance(
 	}
 }
 
+// SimulateMsgPruneAllowances generates a MsgPruneAllowances signed by a
+// random account, exercising the prune path for expired allowances.
+func SimulateMsgPruneAllowances(
+	txConfig client.TxConfig,
+	ak feegrant.AccountKeeper,
+	bk feegrant.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app simtypes.AppEntrypoint, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		signer, _ := simtypes.RandomAcc(r, accs)
+		signerStr, err := ak.AddressCodec().BytesToString(signer.Address)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+
+		account := ak.GetAccount(ctx, signer.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := &feegrant.MsgPruneAllowances{Pruner: signerStr}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      signer,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      feegrant.ModuleName,
+			CoinsSpentInMsg: spendableCoins,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// genRandomAllowance picks a concrete feegrant.FeeAllowanceI implementation
+// according to weights and populates it with random, internally-consistent
+// values derived from spendLimit and blockTime.
+func genRandomAllowance(r *rand.Rand, blockTime time.Time, spendLimit sdk.Coins, weights allowanceWeights) (feegrant.FeeAllowanceI, error) {
+	total := weights.basic + weights.periodic + weights.filtered
+	if total <= 0 {
+		return genBasicAllowance(r, blockTime, spendLimit), nil
+	}
+
+	switch choice := r.Intn(total); {
+	case choice < weights.basic:
+		return genBasicAllowance(r, blockTime, spendLimit), nil
+	case choice < weights.basic+weights.periodic:
+		return genPeriodicAllowance(r, blockTime, spendLimit), nil
+	default:
+		inner := genBasicOrPeriodicAllowance(r, blockTime, spendLimit)
+		return genFilteredAllowance(r, inner)
+	}
+}
+
+// genExpiration returns blockTime plus one year most of the time, but
+// occasionally (shortExpirationChance%) a short expiration a few seconds to
+// minutes out, so that the grant queue actually accumulates entries for
+// SimulateMsgPruneAllowances to find during a sim run.
+func genExpiration(r *rand.Rand, blockTime time.Time) time.Time {
+	if simtypes.RandIntBetween(r, 0, 100) < shortExpirationChance {
+		return blockTime.Add(time.Duration(simtypes.RandIntBetween(r, int(minShortExpiration), int(maxShortExpiration))))
+	}
+	return blockTime.AddDate(1, 0, 0)
+}
+
+// genBasicAllowance returns a BasicAllowance granting spendLimit, expiring
+// per genExpiration.
+func genBasicAllowance(r *rand.Rand, blockTime time.Time, spendLimit sdk.Coins) *feegrant.BasicAllowance {
+	expiration := genExpiration(r, blockTime)
+	return &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: &expiration,
+	}
+}
+
+// genPeriodicAllowance returns a PeriodicAllowance with a random period,
+// a period spend limit bounded by spendLimit, and an initial reset derived
+// from blockTime.
+func genPeriodicAllowance(r *rand.Rand, blockTime time.Time, spendLimit sdk.Coins) *feegrant.PeriodicAllowance {
+	period := time.Duration(simtypes.RandIntBetween(r, int(minPeriod), int(maxPeriod)))
+	periodSpendLimit := simtypes.RandSubsetCoins(r, spendLimit)
+	if periodSpendLimit.Empty() {
+		periodSpendLimit = spendLimit
+	}
+	periodReset := blockTime.Add(period)
+	expiration := genExpiration(r, blockTime)
+
+	return &feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: spendLimit,
+			Expiration: &expiration,
+		},
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      periodReset,
+	}
+}
+
+// genBasicOrPeriodicAllowance picks one of the two non-filtered allowance
+// types, for use as the inner allowance of an AllowedMsgAllowance.
+func genBasicOrPeriodicAllowance(r *rand.Rand, blockTime time.Time, spendLimit sdk.Coins) feegrant.FeeAllowanceI {
+	if r.Intn(2) == 0 {
+		return genBasicAllowance(r, blockTime, spendLimit)
+	}
+	return genPeriodicAllowance(r, blockTime, spendLimit)
+}
+
+// genFilteredAllowance wraps allowance in an AllowedMsgAllowance restricted
+// to a random, non-empty subset of allowedMsgTypeURLs.
+func genFilteredAllowance(r *rand.Rand, allowance feegrant.FeeAllowanceI) (feegrant.FeeAllowanceI, error) {
+	perm := r.Perm(len(allowedMsgTypeURLs))
+	n := simtypes.RandIntBetween(r, 1, len(allowedMsgTypeURLs)+1)
+
+	allowedMsgs := make([]string, n)
+	for i := 0; i < n; i++ {
+		allowedMsgs[i] = allowedMsgTypeURLs[perm[i]]
+	}
+
+	return feegrant.NewAllowedMsgAllowance(allowance, allowedMsgs)
+}
+
 // SimulateMsgRevokeAllowance generates a MsgRevokeAllowance with random values.
 func SimulateMsgRevokeAllowance(
 	txConfig client.TxConfig,